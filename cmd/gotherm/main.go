@@ -0,0 +1,144 @@
+// Command gotherm reads a thermocouple via a MAX31855 or MAX31856 thermocouple-to-digital
+// converter on the SPI bus of a Raspberry Pi 3 Model B+ and logs the readings.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/kidoman/embd"
+	_ "github.com/kidoman/embd/host/all"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/pachapman/gotherm/metrics"
+	"github.com/pachapman/gotherm/sensor/max31855"
+	"github.com/pachapman/gotherm/sensor/max31856"
+)
+
+const pollInterval = 500 * time.Millisecond
+
+func main() {
+	chip := flag.String("chip", "max31855", `thermocouple chip driver to use: "max31855" or "max31856"`)
+	sensorID := flag.String("sensor-id", "gotherm", "sensor_id label applied to exported metrics")
+	metricsAddr := flag.String("metrics-addr", "", `address to serve Prometheus metrics on (e.g. ":9090"); empty disables metrics`)
+	flag.Parse()
+
+	logger := slog.Default()
+
+	var rec *metrics.Recorder
+	if *metricsAddr != "" {
+		reg := prometheus.NewRegistry()
+		rec = metrics.NewRecorder(reg, *sensorID)
+
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", promhttp.HandlerFor(reg, promhttp.HandlerOpts{}))
+		go func() {
+			if err := http.ListenAndServe(*metricsAddr, mux); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("metrics server exited", "err", err)
+			}
+		}()
+		logger.Info("serving metrics", "addr", *metricsAddr)
+	}
+
+	if err := embd.InitSPI(); err != nil {
+		logger.Error("error initializing SPI", "err", err)
+		os.Exit(1)
+	}
+	defer embd.CloseSPI()
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	var err error
+	switch *chip {
+	case "max31855":
+		err = runMAX31855(ctx, logger, rec)
+	case "max31856":
+		err = runMAX31856(ctx, logger, rec)
+	default:
+		logger.Error("unknown chip driver", "chip", *chip)
+		os.Exit(2)
+	}
+	if err != nil {
+		logger.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+// runMAX31855 wires up a MAX31855 and logs its readings, via the channel-based Poller, until
+// ctx is cancelled.
+func runMAX31855(ctx context.Context, logger *slog.Logger, rec *metrics.Recorder) error {
+	spiBus := embd.NewSPIBus(embd.SPIMode0, 0, 1000000, 8, 0)
+	dev := max31855.New(spiBus)
+	defer dev.Close()
+
+	poller := max31855.NewPoller(dev, pollInterval)
+	poller.SetLogger(logger)
+	if rec != nil {
+		poller.SetMetrics(rec)
+	}
+
+	readings := poller.Start(ctx)
+	for {
+		select {
+		case _, ok := <-readings:
+			if !ok {
+				return nil
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// runMAX31856 wires up a MAX31856 and logs its readings until ctx is cancelled.
+func runMAX31856(ctx context.Context, logger *slog.Logger, rec *metrics.Recorder) error {
+	spiBus := embd.NewSPIBus(max31856.SPIMode, 0, max31856.SPISpeed, 8, 0)
+	dev, err := max31856.New(spiBus)
+	if err != nil {
+		return fmt.Errorf("initializing MAX31856: %w", err)
+	}
+	defer dev.Close()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reading, err := dev.Read()
+			if err != nil {
+				logger.Error("spi transfer failed", "err", err)
+				if rec != nil {
+					rec.RecordReadError()
+				}
+				continue
+			}
+
+			if reading.Fault {
+				logger.Warn("sensor fault", "kinds", reading.FaultKinds, "message", reading.FaultMessage)
+				if rec != nil {
+					for _, kind := range reading.FaultKinds {
+						rec.RecordFault(kind)
+					}
+				}
+				continue
+			}
+
+			logger.Info("sensor reading", "internal_c", reading.Internal, "thermocouple_c", reading.Thermocouple)
+			if rec != nil {
+				rec.RecordReading(reading.Internal, reading.Thermocouple, reading.LastUpdate)
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Package metrics exposes Prometheus instrumentation for a polled thermocouple sensor, for
+// use in long-running deployments (kiln/fermentor controllers and the like).
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Recorder records readings and faults from a single sensor as Prometheus metrics, labelled
+// by a user-supplied sensor ID.
+type Recorder struct {
+	thermocoupleCelsius prometheus.Gauge
+	internalCelsius     prometheus.Gauge
+	lastReadUnix        prometheus.Gauge
+	readErrorsTotal     prometheus.Counter
+	faultsTotal         *prometheus.CounterVec
+}
+
+// NewRecorder creates a Recorder for sensorID and registers its metrics with reg.
+func NewRecorder(reg prometheus.Registerer, sensorID string) *Recorder {
+	constLabels := prometheus.Labels{"sensor_id": sensorID}
+
+	r := &Recorder{
+		thermocoupleCelsius: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "gotherm_thermocouple_celsius",
+			Help:        "Most recent thermocouple temperature reading, in Celsius.",
+			ConstLabels: constLabels,
+		}),
+		internalCelsius: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "gotherm_internal_celsius",
+			Help:        "Most recent internal (cold-junction) temperature reading, in Celsius.",
+			ConstLabels: constLabels,
+		}),
+		lastReadUnix: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name:        "gotherm_last_read_unix",
+			Help:        "Unix timestamp of the most recent successful reading.",
+			ConstLabels: constLabels,
+		}),
+		readErrorsTotal: prometheus.NewCounter(prometheus.CounterOpts{
+			Name:        "gotherm_read_errors_total",
+			Help:        "Total number of SPI transfer errors while reading the sensor.",
+			ConstLabels: constLabels,
+		}),
+		faultsTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name:        "gotherm_faults_total",
+			Help:        `Total number of sensor faults, labelled by kind ("open", "scg", or "scv").`,
+			ConstLabels: constLabels,
+		}, []string{"kind"}),
+	}
+
+	reg.MustRegister(r.thermocoupleCelsius, r.internalCelsius, r.lastReadUnix, r.readErrorsTotal, r.faultsTotal)
+	return r
+}
+
+// RecordReading updates the gauges from a successful reading.
+func (r *Recorder) RecordReading(internal, thermocouple float32, lastUpdate int64) {
+	r.internalCelsius.Set(float64(internal))
+	r.thermocoupleCelsius.Set(float64(thermocouple))
+	r.lastReadUnix.Set(float64(lastUpdate))
+}
+
+// RecordReadError increments the read-error counter.
+func (r *Recorder) RecordReadError() {
+	r.readErrorsTotal.Inc()
+}
+
+// RecordFault increments the faults counter for the given kind (e.g. "open", "scg", "scv").
+func (r *Recorder) RecordFault(kind string) {
+	r.faultsTotal.WithLabelValues(kind).Inc()
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestRecordReading(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	rec := NewRecorder(reg, "kiln1")
+
+	rec.RecordReading(22.5, 215.0, 1700000000)
+
+	want := `
+# HELP gotherm_thermocouple_celsius Most recent thermocouple temperature reading, in Celsius.
+# TYPE gotherm_thermocouple_celsius gauge
+gotherm_thermocouple_celsius{sensor_id="kiln1"} 215
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), "gotherm_thermocouple_celsius"); err != nil {
+		t.Errorf("unexpected metrics: %v", err)
+	}
+}
+
+func TestRecordReadErrorAndFault(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	rec := NewRecorder(reg, "kiln1")
+
+	rec.RecordReadError()
+	rec.RecordFault("open")
+	rec.RecordFault("open")
+
+	if got := testutil.ToFloat64(rec.readErrorsTotal); got != 1 {
+		t.Errorf("readErrorsTotal = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(rec.faultsTotal.WithLabelValues("open")); got != 2 {
+		t.Errorf("faultsTotal{kind=open} = %v, want 2", got)
+	}
+}
@@ -0,0 +1,120 @@
+package max31855
+
+import "sync"
+
+// AlertKind identifies why OnAlert's callback fired.
+type AlertKind int
+
+// Supported alert kinds.
+const (
+	// AlertLower fires when the thermocouple reading drops below the configured window lower bound.
+	AlertLower AlertKind = iota
+	// AlertUpper fires when the thermocouple reading rises above the configured window upper bound.
+	AlertUpper
+	// AlertCritical fires when the thermocouple reading reaches the configured critical temperature.
+	AlertCritical
+	// AlertFault fires when a reading reports a sensor fault (open circuit, short to ground/power).
+	AlertFault
+)
+
+func (k AlertKind) String() string {
+	switch k {
+	case AlertLower:
+		return "lower"
+	case AlertUpper:
+		return "upper"
+	case AlertCritical:
+		return "critical"
+	case AlertFault:
+		return "fault"
+	default:
+		return "unknown"
+	}
+}
+
+// defaultAlertDebounce is how many consecutive samples must agree before an alert fires, if
+// SetAlertDebounce has not been called.
+const defaultAlertDebounce = 1
+
+// alertConfig holds the user-configured thresholds, debounce count, and callback for a Device.
+// The MAX31855 has no hardware comparator (unlike the MCP9808), so this is evaluated in
+// software by the Poller on every reading.
+type alertConfig struct {
+	mu sync.Mutex
+
+	windowLower *float32
+	windowUpper *float32
+	critical    *float32
+	debounce    int
+	onAlert     func(TempReading, AlertKind)
+}
+
+// alertSnapshot is an immutable copy of alertConfig taken under lock, safe for the Poller to
+// use without holding the Device's mutex.
+type alertSnapshot struct {
+	windowLower *float32
+	windowUpper *float32
+	critical    *float32
+	debounce    int
+	onAlert     func(TempReading, AlertKind)
+}
+
+// SetWindowTempLower sets the lower bound of the normal temperature window, in Celsius.
+// AlertLower fires once the thermocouple reading stays below it for the debounce count.
+func (d *Device) SetWindowTempLower(c float32) {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	d.alertCfg.windowLower = &c
+}
+
+// SetWindowTempUpper sets the upper bound of the normal temperature window, in Celsius.
+// AlertUpper fires once the thermocouple reading stays above it for the debounce count.
+func (d *Device) SetWindowTempUpper(c float32) {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	d.alertCfg.windowUpper = &c
+}
+
+// SetCriticalTemp sets the critical temperature, in Celsius. AlertCritical fires once the
+// thermocouple reading stays at or above it for the debounce count.
+func (d *Device) SetCriticalTemp(c float32) {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	d.alertCfg.critical = &c
+}
+
+// SetAlertDebounce sets how many consecutive samples must meet a threshold before its alert
+// fires, to suppress noise near the boundary. The default is 1 (fire on the first sample).
+func (d *Device) SetAlertDebounce(samples int) {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	d.alertCfg.debounce = samples
+}
+
+// OnAlert registers a callback invoked by a Poller when a reading crosses a configured
+// threshold. The callback only fires on transitions: it will not fire again for the same
+// AlertKind until the reading has gone back within bounds and crossed it again. It is invoked
+// synchronously off the Poller's goroutine, so it must not block.
+func (d *Device) OnAlert(f func(TempReading, AlertKind)) {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	d.alertCfg.onAlert = f
+}
+
+// snapshot returns a copy of the current alert configuration for the Poller to evaluate
+// against, without holding the Device's lock for the duration of the evaluation.
+func (d *Device) snapshot() alertSnapshot {
+	d.alertCfg.mu.Lock()
+	defer d.alertCfg.mu.Unlock()
+	debounce := d.alertCfg.debounce
+	if debounce <= 0 {
+		debounce = defaultAlertDebounce
+	}
+	return alertSnapshot{
+		windowLower: d.alertCfg.windowLower,
+		windowUpper: d.alertCfg.windowUpper,
+		critical:    d.alertCfg.critical,
+		debounce:    debounce,
+		onAlert:     d.alertCfg.onAlert,
+	}
+}
@@ -0,0 +1,97 @@
+package max31855
+
+import "testing"
+
+func TestCheckAlertsFiresOnceAfterDebounce(t *testing.T) {
+	dev := New(&fakeSPIBus{})
+	dev.SetWindowTempUpper(100)
+	dev.SetAlertDebounce(3)
+
+	var fired []AlertKind
+	dev.OnAlert(func(_ TempReading, kind AlertKind) {
+		fired = append(fired, kind)
+	})
+
+	p := NewPoller(dev, minPollInterval)
+	hot := TempReading{Thermocouple: 150}
+
+	// Below debounce count: no callback yet.
+	p.checkAlerts(hot)
+	p.checkAlerts(hot)
+	if len(fired) != 0 {
+		t.Fatalf("fired %v before reaching the debounce count", fired)
+	}
+
+	// Third consecutive sample crosses the debounce count.
+	p.checkAlerts(hot)
+	if len(fired) != 1 || fired[0] != AlertUpper {
+		t.Fatalf("fired = %v, want exactly one AlertUpper", fired)
+	}
+
+	// Still hot: must not fire again until the reading goes back in-window.
+	p.checkAlerts(hot)
+	p.checkAlerts(hot)
+	if len(fired) != 1 {
+		t.Fatalf("fired = %v, want no additional alerts while still above the threshold", fired)
+	}
+
+	// Back in window, then hot again: a fresh transition should fire once more.
+	p.checkAlerts(TempReading{Thermocouple: 50})
+	p.checkAlerts(hot)
+	p.checkAlerts(hot)
+	p.checkAlerts(hot)
+	if len(fired) != 2 || fired[1] != AlertUpper {
+		t.Fatalf("fired = %v, want a second AlertUpper after returning to normal and re-triggering", fired)
+	}
+}
+
+func TestCheckAlertsFault(t *testing.T) {
+	dev := New(&fakeSPIBus{})
+	var fired []AlertKind
+	dev.OnAlert(func(_ TempReading, kind AlertKind) {
+		fired = append(fired, kind)
+	})
+
+	p := NewPoller(dev, minPollInterval)
+	p.checkAlerts(TempReading{Fault: true, FaultMessage: "Open circuit to thermometer probe"})
+
+	if len(fired) != 1 || fired[0] != AlertFault {
+		t.Fatalf("fired = %v, want exactly one AlertFault", fired)
+	}
+}
+
+func TestCheckAlertsFaultSuppressesWindowAlerts(t *testing.T) {
+	dev := New(&fakeSPIBus{})
+	dev.SetWindowTempLower(20)
+
+	var fired []AlertKind
+	dev.OnAlert(func(_ TempReading, kind AlertKind) {
+		fired = append(fired, kind)
+	})
+
+	p := NewPoller(dev, minPollInterval)
+	p.checkAlerts(TempReading{Fault: true, FaultMessage: "Open circuit to thermometer probe"})
+
+	if len(fired) != 1 || fired[0] != AlertFault {
+		t.Fatalf("fired = %v, want exactly one AlertFault and no AlertLower", fired)
+	}
+}
+
+func TestCheckAlertsCriticalAndLower(t *testing.T) {
+	dev := New(&fakeSPIBus{})
+	dev.SetWindowTempLower(0)
+	dev.SetCriticalTemp(200)
+
+	var fired []AlertKind
+	dev.OnAlert(func(_ TempReading, kind AlertKind) {
+		fired = append(fired, kind)
+	})
+
+	p := NewPoller(dev, minPollInterval)
+	p.checkAlerts(TempReading{Thermocouple: -10})
+	p.checkAlerts(TempReading{Thermocouple: 250})
+
+	if len(fired) != 2 || fired[0] != AlertLower || fired[1] != AlertCritical {
+		t.Fatalf("fired = %v, want [AlertLower AlertCritical]", fired)
+	}
+}
@@ -0,0 +1,135 @@
+// Package max31855 allows interfacing with the Maxim MAX31855 thermocouple-to-digital
+// converter over SPI. The datasheet can be found here:
+// https://cdn-shop.adafruit.com/datasheets/MAX31855.pdf
+package max31855
+
+import (
+	"encoding/binary"
+	"time"
+
+	"github.com/kidoman/embd"
+)
+
+// TempReading holds a user-friendly decoding of a single MAX31855 SPI frame.
+type TempReading struct {
+	Internal     float32   // Internal (cold-junction) reading
+	Thermocouple float32   // Thermocouple reading
+	Fault        bool      // True if there is a fault
+	FaultKind    FaultKind // A machine-readable fault category, if any
+	FaultMessage string    // A message describing the fault, if any
+	LastUpdate   int64     // When the reading was updated
+	Raw          [4]uint8  // The raw SPI frame the reading was decoded from
+}
+
+// FaultKind categorizes a MAX31855 fault for consumers (such as the metrics subpackage) that
+// want to label or count faults without parsing FaultMessage.
+type FaultKind string
+
+// Supported fault kinds.
+const (
+	FaultOpen FaultKind = "open" // Open circuit to thermometer probe
+	FaultSCG  FaultKind = "scg"  // Thermometer probe shorted to ground
+	FaultSCV  FaultKind = "scv"  // Thermometer probe shorted to power
+)
+
+// Device represents a MAX31855 connected to an SPI bus.
+type Device struct {
+	bus embd.SPIBus
+
+	alertCfg alertConfig
+}
+
+// New returns a handle to a MAX31855 on the given SPI bus.
+func New(bus embd.SPIBus) *Device {
+	return &Device{bus: bus}
+}
+
+// Read performs a single SPI transfer and returns the decoded reading.
+func (d *Device) Read() (TempReading, error) {
+	dataBuf := [4]uint8{0, 0, 0, 0}
+	if err := d.bus.TransferAndReceiveData(dataBuf[:]); err != nil {
+		return TempReading{}, err
+	}
+	return parseTempReading(dataBuf), nil
+}
+
+// Close releases the resources associated with the underlying SPI bus.
+func (d *Device) Close() error {
+	return d.bus.Close()
+}
+
+// Parses the data from the SPI and returns a TempReading object containing user-friendly information.
+func parseTempReading(dataBuf [4]uint8) TempReading {
+	reading := TempReading{LastUpdate: time.Now().Unix(), Raw: dataBuf}
+	// Check the bits that report faults first
+	checkErrors(&reading, dataBuf)
+	if !reading.Fault {
+		// No faults, Parse readings
+		parseInternalTemp(dataBuf, &reading)
+		parseExternalTemp(dataBuf, &reading)
+	}
+	return reading
+}
+
+// Constants for bitmasks used to gather info from the data returned from SPI
+// First byte
+const ocErrBit byte = 128    // 10000000
+const scgErrBit byte = 64    // 01000000
+const scvErrBit byte = 32    // 00100000
+const intTempSign byte = 8   // 00001000
+const intTempByte1 byte = 15 // 00001111
+// Second byte
+const intTempByte2 byte = 255 // 11111111
+// Third byte
+const errorBit byte = 128   // 10000000
+const tcTempByte1 byte = 63 // 00111111
+const tcTempSign byte = 32  // 00100000
+// Fourth byte
+const tcTempByte2 byte = 255 // 11111111
+
+// Check the bits that report faults
+func checkErrors(reading *TempReading, data [4]uint8) {
+	if data[2]&errorBit != 0 {
+		reading.Fault = true
+		if data[0]&ocErrBit != 0 {
+			reading.FaultKind = FaultOpen
+			reading.FaultMessage = "Open circuit to thermometer probe"
+		} else if data[0]&scgErrBit != 0 {
+			reading.FaultKind = FaultSCG
+			reading.FaultMessage = "Thermometer probe shorted to ground"
+		} else if data[0]&scvErrBit != 0 {
+			reading.FaultKind = FaultSCV
+			reading.FaultMessage = "Thermometer probe shorted to power"
+		}
+	} else {
+		reading.Fault = false
+	}
+}
+
+// Parse the temp of the internal sensor
+func parseInternalTemp(data [4]uint8, reading *TempReading) {
+	// the internal temp is composed of last 4 bits of byte 1 and all of byte 2
+	a := byte(data[0] & intTempByte1)
+	b := byte(data[1])
+	c := binary.BigEndian.Uint16([]byte{a, b})
+	v := int16(c)
+	if (data[0] & intTempSign) != 0 {
+		// Negative number: the 12-bit value is sign-extended to int16.
+		v |= -0x1000
+	}
+	reading.Internal = float32(v) * 0.0625
+}
+
+// Parse the temp of the thermocouple sensor
+func parseExternalTemp(data [4]uint8, reading *TempReading) {
+	// the external temp is composed of the last 6 bits of byte 3 and all of byte 4
+	a := byte(data[2] & tcTempByte1)
+	b := byte(data[3])
+	c := binary.BigEndian.Uint16([]byte{a, b})
+	v := int16(c)
+	if (data[2] & tcTempSign) != 0 {
+		// Negative number: the 14-bit value is sign-extended to int16.
+		v |= -0x4000
+	}
+	reading.Thermocouple = float32(v) * 0.25
+}
@@ -0,0 +1,114 @@
+package max31855
+
+import (
+	"errors"
+	"testing"
+)
+
+// fakeSPIBus implements embd.SPIBus, returning a fixed 4-byte frame on every transfer.
+type fakeSPIBus struct {
+	frame [4]uint8
+	err   error
+}
+
+func (b *fakeSPIBus) Write(p []byte) (int, error) { return len(p), nil }
+
+func (b *fakeSPIBus) TransferAndReceiveData(dataBuffer []uint8) error {
+	if b.err != nil {
+		return b.err
+	}
+	copy(dataBuffer, b.frame[:])
+	return nil
+}
+
+func (b *fakeSPIBus) ReceiveData(len int) ([]uint8, error)           { return nil, nil }
+func (b *fakeSPIBus) TransferAndReceiveByte(data byte) (byte, error) { return 0, nil }
+func (b *fakeSPIBus) ReceiveByte() (byte, error)                     { return 0, nil }
+func (b *fakeSPIBus) Close() error                                   { return nil }
+
+func TestDeviceRead(t *testing.T) {
+	bus := &fakeSPIBus{frame: [4]uint8{0x00, 0x00, 0x1C, 0x20}}
+	dev := New(bus)
+
+	reading, err := dev.Read()
+	if err != nil {
+		t.Fatalf("Read() returned error: %v", err)
+	}
+	if reading.Fault {
+		t.Fatalf("Read() reported a fault for a clean frame: %s", reading.FaultMessage)
+	}
+	if reading.Thermocouple != 1800.0 {
+		t.Errorf("reading.Thermocouple = %v, want 1800", reading.Thermocouple)
+	}
+}
+
+func TestDeviceReadTransferError(t *testing.T) {
+	wantErr := errors.New("spi bus failure")
+	bus := &fakeSPIBus{err: wantErr}
+	dev := New(bus)
+
+	if _, err := dev.Read(); err != wantErr {
+		t.Errorf("Read() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestParseInternalTemp(t *testing.T) {
+	tests := []struct {
+		name string
+		data [4]uint8
+		want float32
+	}{
+		{"zero", [4]uint8{0x00, 0x00, 0x00, 0x00}, 0},
+		{"positive near max", [4]uint8{0x07, 0xD0, 0x00, 0x00}, 125.0},
+		{"small negative, -0.0625C", [4]uint8{0x0F, 0xFF, 0x00, 0x00}, -0.0625},
+		{"negative near extreme, -55C", [4]uint8{0x0C, 0x90, 0x00, 0x00}, -55.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reading := &TempReading{}
+			parseInternalTemp(tt.data, reading)
+			if reading.Internal != tt.want {
+				t.Errorf("parseInternalTemp(%v) = %v, want %v", tt.data, reading.Internal, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseExternalTemp(t *testing.T) {
+	tests := []struct {
+		name string
+		data [4]uint8
+		want float32
+	}{
+		{"zero", [4]uint8{0x00, 0x00, 0x00, 0x00}, 0},
+		{"positive, +1800C", [4]uint8{0x00, 0x00, 0x1C, 0x20}, 1800.0},
+		{"small negative, -0.25C", [4]uint8{0x00, 0x00, 0x3F, 0xFF}, -0.25},
+		{"negative near extreme, -270C", [4]uint8{0x00, 0x00, 0x3B, 0xC8}, -270.0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reading := &TempReading{}
+			parseExternalTemp(tt.data, reading)
+			if reading.Thermocouple != tt.want {
+				t.Errorf("parseExternalTemp(%v) = %v, want %v", tt.data, reading.Thermocouple, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeviceReadFault(t *testing.T) {
+	// Fault bit set in byte 2, with the OC bit set in byte 0: an open circuit to the probe.
+	bus := &fakeSPIBus{frame: [4]uint8{0x80, 0x00, 0x80, 0x00}}
+	dev := New(bus)
+
+	reading, err := dev.Read()
+	if err != nil {
+		t.Fatalf("Read() returned error: %v", err)
+	}
+	if !reading.Fault {
+		t.Fatal("Read() did not report a fault for a frame with the fault bit set")
+	}
+	if reading.FaultKind != FaultOpen {
+		t.Errorf("reading.FaultKind = %v, want %v", reading.FaultKind, FaultOpen)
+	}
+}
@@ -0,0 +1,166 @@
+package max31855
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// minPollInterval is the shortest supported polling interval. The MAX31855 needs
+// roughly 100ms to complete a conversion, so polling any faster just re-reads stale data.
+const minPollInterval = 100 * time.Millisecond
+
+// readingBufferSize and errBufferSize bound how many unread readings/errors a Poller
+// will buffer before it starts dropping the oldest ones in favor of fresher data.
+const readingBufferSize = 16
+const errBufferSize = 8
+
+// Poller periodically reads a Device at a fixed interval and publishes the results on
+// channels, so callers can fan a single device out to multiple sinks (stdout, metrics,
+// an HTTP handler) without each sink driving its own SPI transfers.
+type Poller struct {
+	dev      *Device
+	interval time.Duration
+
+	readings chan TempReading
+	errs     chan error
+
+	alertStreaks map[AlertKind]int
+	alertActive  map[AlertKind]bool
+
+	logger  *slog.Logger
+	metrics MetricsRecorder
+}
+
+// MetricsRecorder receives every reading and fault a Poller observes, for external
+// instrumentation. See the metrics subpackage for a Prometheus-backed implementation.
+type MetricsRecorder interface {
+	RecordReading(internal, thermocouple float32, lastUpdate int64)
+	RecordReadError()
+	RecordFault(kind string)
+}
+
+// NewPoller returns a Poller that reads dev every interval. interval is clamped to
+// minPollInterval if it is shorter.
+func NewPoller(dev *Device, interval time.Duration) *Poller {
+	if interval < minPollInterval {
+		interval = minPollInterval
+	}
+	return &Poller{
+		dev:          dev,
+		interval:     interval,
+		readings:     make(chan TempReading, readingBufferSize),
+		errs:         make(chan error, errBufferSize),
+		alertStreaks: make(map[AlertKind]int),
+		alertActive:  make(map[AlertKind]bool),
+		logger:       slog.Default(),
+	}
+}
+
+// SetLogger overrides the logger used to report faults, SPI errors, and raw frames. The
+// default logs to a text handler on stderr; pass a *slog.Logger backed by a JSON handler to
+// capture structured logs in production.
+func (p *Poller) SetLogger(logger *slog.Logger) {
+	p.logger = logger
+}
+
+// SetMetrics registers a MetricsRecorder that is updated with every reading and fault.
+func (p *Poller) SetMetrics(m MetricsRecorder) {
+	p.metrics = m
+}
+
+// Start spawns the polling goroutine and returns the channel of readings. The goroutine
+// ticks at the configured interval, reading the device and pushing results onto the
+// returned channel. It stops and closes both the readings and Errors channels once ctx
+// is cancelled.
+func (p *Poller) Start(ctx context.Context) <-chan TempReading {
+	go p.run(ctx)
+	return p.readings
+}
+
+// Errors returns the channel that transient SPI errors are reported on. It is safe to
+// leave unread; errors are dropped rather than blocking the poller once the buffer fills.
+func (p *Poller) Errors() <-chan error {
+	return p.errs
+}
+
+func (p *Poller) run(ctx context.Context) {
+	defer close(p.readings)
+	defer close(p.errs)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reading, err := p.dev.Read()
+			if err != nil {
+				p.logger.Error("spi transfer failed", "err", err)
+				if p.metrics != nil {
+					p.metrics.RecordReadError()
+				}
+				select {
+				case p.errs <- err:
+				default:
+					// Drop the error rather than block the poller if nobody is listening.
+				}
+				continue
+			}
+
+			p.logger.Debug("raw spi frame", "raw_frame", reading.Raw)
+			if reading.Fault {
+				p.logger.Warn("sensor fault", "kind", reading.FaultKind, "message", reading.FaultMessage)
+				if p.metrics != nil {
+					p.metrics.RecordFault(string(reading.FaultKind))
+				}
+			} else {
+				p.logger.Info("sensor reading", "internal_c", reading.Internal, "thermocouple_c", reading.Thermocouple)
+				if p.metrics != nil {
+					p.metrics.RecordReading(reading.Internal, reading.Thermocouple, reading.LastUpdate)
+				}
+			}
+
+			p.checkAlerts(reading)
+			select {
+			case p.readings <- reading:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// checkAlerts compares reading against the Device's configured thresholds and invokes its
+// OnAlert callback for any kind that has met its debounce count since the last time it fired.
+func (p *Poller) checkAlerts(reading TempReading) {
+	cfg := p.dev.snapshot()
+
+	// A faulted reading has no valid Thermocouple value (parseTempReading skips parsing it),
+	// so only AlertFault applies; evaluating the window/critical thresholds against the zero
+	// value would fire spurious alerts alongside the real one.
+	triggered := map[AlertKind]bool{
+		AlertLower:    !reading.Fault && cfg.windowLower != nil && reading.Thermocouple < *cfg.windowLower,
+		AlertUpper:    !reading.Fault && cfg.windowUpper != nil && reading.Thermocouple > *cfg.windowUpper,
+		AlertCritical: !reading.Fault && cfg.critical != nil && reading.Thermocouple >= *cfg.critical,
+		AlertFault:    reading.Fault,
+	}
+
+	for kind, isTriggered := range triggered {
+		if !isTriggered {
+			p.alertStreaks[kind] = 0
+			p.alertActive[kind] = false
+			continue
+		}
+		p.alertStreaks[kind]++
+		if p.alertActive[kind] || p.alertStreaks[kind] < cfg.debounce {
+			continue
+		}
+		p.alertActive[kind] = true
+		if cfg.onAlert != nil {
+			cfg.onAlert(reading, kind)
+		}
+	}
+}
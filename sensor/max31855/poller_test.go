@@ -0,0 +1,76 @@
+package max31855
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewPollerClampsInterval(t *testing.T) {
+	p := NewPoller(New(&fakeSPIBus{}), time.Millisecond)
+	if p.interval != minPollInterval {
+		t.Errorf("interval = %v, want %v", p.interval, minPollInterval)
+	}
+}
+
+func TestPollerStartDeliversReadings(t *testing.T) {
+	bus := &fakeSPIBus{frame: [4]uint8{0x00, 0x00, 0x1C, 0x20}}
+	p := NewPoller(New(bus), minPollInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	readings := p.Start(ctx)
+
+	select {
+	case reading := <-readings:
+		if reading.Thermocouple != 1800.0 {
+			t.Errorf("reading.Thermocouple = %v, want 1800", reading.Thermocouple)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for a reading")
+	}
+}
+
+func TestPollerReportsErrors(t *testing.T) {
+	wantErr := errors.New("spi bus failure")
+	bus := &fakeSPIBus{err: wantErr}
+	p := NewPoller(New(bus), minPollInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p.Start(ctx)
+
+	select {
+	case err := <-p.Errors():
+		if err != wantErr {
+			t.Errorf("err = %v, want %v", err, wantErr)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for an error")
+	}
+}
+
+func TestPollerStopsOnContextCancel(t *testing.T) {
+	bus := &fakeSPIBus{frame: [4]uint8{0x00, 0x00, 0x1C, 0x20}}
+	p := NewPoller(New(bus), minPollInterval)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	readings := p.Start(ctx)
+	cancel()
+
+	select {
+	case _, ok := <-readings:
+		if ok {
+			// Drain any reading already in flight before the cancel took effect, then
+			// the channel must close.
+			if _, ok := <-readings; ok {
+				t.Fatal("readings channel did not close after context cancellation")
+			}
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for readings channel to close")
+	}
+}
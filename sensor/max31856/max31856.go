@@ -0,0 +1,273 @@
+// Package max31856 allows interfacing with the Maxim MAX31856 precision thermocouple-to-digital
+// converter over SPI. Unlike the MAX31855 (see the sibling sensor/max31855 package), the
+// MAX31856 supports selectable thermocouple types with on-chip linearization, configurable
+// sample averaging, and programmable open-circuit fault detection. The datasheet can be found
+// here: https://datasheets.maximintegrated.com/en/ds/MAX31856.pdf
+package max31856
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/kidoman/embd"
+)
+
+// SPIMode and SPISpeed are the bus settings the MAX31856 requires: Mode 1 (CPOL=0, CPHA=1)
+// at no more than 4MHz.
+const (
+	SPIMode  = embd.SPIMode1
+	SPISpeed = 4000000
+)
+
+// Type selects the thermocouple connected to the MAX31856, written to the TC Type bits of CR1.
+type Type byte
+
+// Supported thermocouple types, matching the TC Type field of the CR1 register.
+const (
+	TypeB Type = iota
+	TypeE
+	TypeJ
+	TypeK
+	TypeN
+	TypeR
+	TypeS
+	TypeT
+)
+
+// Averaging selects how many samples the MAX31856 averages per conversion, written to the
+// AVGSEL bits of CR1.
+type Averaging byte
+
+// Supported averaging modes.
+const (
+	Average1 Averaging = iota
+	Average2
+	Average4
+	Average8
+	Average16
+)
+
+// OpenCircuitMode selects the open-circuit fault detection mode, written to the OCFAULT bits
+// of CR0. Higher modes detect higher-resistance opens at the cost of a longer conversion time.
+type OpenCircuitMode byte
+
+// Supported open-circuit detection modes.
+const (
+	OpenCircuitDisabled OpenCircuitMode = iota
+	OpenCircuitMode1
+	OpenCircuitMode2
+	OpenCircuitMode3
+)
+
+// TempReading holds a user-friendly decoding of a single MAX31856 conversion.
+type TempReading struct {
+	Internal     float32  // Cold-junction reading
+	Thermocouple float32  // Linearized thermocouple reading
+	Fault        bool     // True if there is a fault
+	FaultKinds   []string // The SR register fault bits that are set, e.g. "open", "tchigh"
+	FaultMessage string   // A message describing the fault(s), if any
+	LastUpdate   int64    // When the reading was updated
+}
+
+// Device represents a MAX31856 connected to an SPI bus. Callers are expected to configure the
+// SPI bus for SPIMode at SPISpeed (or slower) before use.
+type Device struct {
+	bus embd.SPIBus
+
+	tcType Type
+	avg    Averaging
+	ocMode OpenCircuitMode
+}
+
+// New returns a handle to a MAX31856 on the given SPI bus, configured with the default
+// thermocouple type (K) and averaging (1 sample), and open-circuit detection disabled.
+func New(bus embd.SPIBus) (*Device, error) {
+	d := &Device{bus: bus, tcType: TypeK, avg: Average1, ocMode: OpenCircuitDisabled}
+	if err := d.writeConfig(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// SetType selects the thermocouple type and writes it to CR1.
+func (d *Device) SetType(t Type) error {
+	d.tcType = t
+	return d.writeConfig()
+}
+
+// SetAveraging selects how many samples the MAX31856 averages per conversion and writes it to
+// CR1.
+func (d *Device) SetAveraging(avg Averaging) error {
+	d.avg = avg
+	return d.writeConfig()
+}
+
+// SetOpenCircuitMode selects the open-circuit fault detection mode and writes it to CR0.
+func (d *Device) SetOpenCircuitMode(mode OpenCircuitMode) error {
+	d.ocMode = mode
+	return d.writeConfig()
+}
+
+// writeConfig pushes the cached tcType/avg/ocMode onto CR0 and CR1.
+func (d *Device) writeConfig() error {
+	cr0 := byte(d.ocMode) << 4
+	if err := d.writeRegister(regCR0, cr0); err != nil {
+		return err
+	}
+	cr1 := byte(d.avg)<<4 | byte(d.tcType)
+	return d.writeRegister(regCR1, cr1)
+}
+
+// Read triggers a conversion readout and returns the decoded reading.
+func (d *Device) Read() (TempReading, error) {
+	reading := TempReading{LastUpdate: time.Now().Unix()}
+
+	sr, err := d.readRegister(regSR)
+	if err != nil {
+		return TempReading{}, err
+	}
+	if kinds := decodeFaultKinds(sr); len(kinds) > 0 {
+		reading.Fault = true
+		reading.FaultKinds = kinds
+		reading.FaultMessage = strings.Join(faultMessages(kinds), "; ")
+		return reading, nil
+	}
+
+	cj, err := d.readRegisters(regCJTH, 2)
+	if err != nil {
+		return TempReading{}, err
+	}
+	reading.Internal = parseColdJunction(cj[0], cj[1])
+
+	tc, err := d.readRegisters(regLTCBH, 3)
+	if err != nil {
+		return TempReading{}, err
+	}
+	reading.Thermocouple = parseThermocouple(tc[0], tc[1], tc[2])
+
+	return reading, nil
+}
+
+// Close releases the resources associated with the underlying SPI bus.
+func (d *Device) Close() error {
+	return d.bus.Close()
+}
+
+// Register addresses, per the MAX31856 datasheet register map.
+const (
+	regCR0   = 0x00
+	regCR1   = 0x01
+	regCJTH  = 0x0A
+	regCJTL  = 0x0B
+	regLTCBH = 0x0C
+	regLTCBM = 0x0D
+	regLTCBL = 0x0E
+	regSR    = 0x0F
+
+	writeBit = 0x80
+)
+
+// Fault bits of the SR (0x0F) register.
+const (
+	faultOpen   = 0x01 // OPEN: thermocouple open circuit
+	faultOVUV   = 0x02 // OVUV: overvoltage/undervoltage input fault
+	faultTCLow  = 0x04 // TCLOW: thermocouple low threshold
+	faultTCHigh = 0x08 // TCHIGH: thermocouple high threshold
+	faultCJLow  = 0x10 // CJLOW: cold-junction low threshold
+	faultCJHigh = 0x20 // CJHIGH: cold-junction high threshold
+)
+
+// faultDescriptions maps each named fault kind to a human-readable description, checked in
+// the same order the SR register bits are defined.
+var faultDescriptions = []struct {
+	bit  byte
+	kind string
+	desc string
+}{
+	{faultCJHigh, "cjhigh", "cold-junction high threshold exceeded"},
+	{faultCJLow, "cjlow", "cold-junction low threshold exceeded"},
+	{faultTCHigh, "tchigh", "thermocouple high threshold exceeded"},
+	{faultTCLow, "tclow", "thermocouple low threshold exceeded"},
+	{faultOVUV, "ovuv", "thermocouple input over/under voltage"},
+	{faultOpen, "open", "thermocouple open circuit"},
+}
+
+// decodeFaultKinds returns the named fault kinds set in the SR register, or nil if none are
+// set.
+func decodeFaultKinds(sr byte) []string {
+	var kinds []string
+	for _, f := range faultDescriptions {
+		if sr&f.bit != 0 {
+			kinds = append(kinds, f.kind)
+		}
+	}
+	return kinds
+}
+
+// faultMessages turns decoded fault kinds into their human-readable descriptions.
+func faultMessages(kinds []string) []string {
+	msgs := make([]string, 0, len(kinds))
+	for _, kind := range kinds {
+		for _, f := range faultDescriptions {
+			if f.kind == kind {
+				msgs = append(msgs, f.desc)
+				break
+			}
+		}
+	}
+	return msgs
+}
+
+// parseColdJunction decodes the 14-bit cold-junction temperature from CJTH/CJTL, scaled by
+// 0.015625 C per bit.
+func parseColdJunction(cjth, cjtl byte) float32 {
+	raw := int32(uint16(cjth)<<8 | uint16(cjtl))
+	raw >>= 2
+	if raw&0x2000 != 0 {
+		// Sign-extend from bit 13.
+		raw |= ^int32(0x3FFF)
+	}
+	return float32(raw) * 0.015625
+}
+
+// parseThermocouple decodes the 19-bit linearized thermocouple temperature from
+// LTCBH/LTCBM/LTCBL, scaled by 0.0078125 C per bit.
+func parseThermocouple(ltcbh, ltcbm, ltcbl byte) float32 {
+	raw := int32(uint32(ltcbh)<<16 | uint32(ltcbm)<<8 | uint32(ltcbl))
+	raw >>= 5
+	if raw&0x40000 != 0 {
+		// Sign-extend from bit 18.
+		raw |= ^int32(0x7FFFF)
+	}
+	return float32(raw) * 0.0078125
+}
+
+// readRegister reads a single register.
+func (d *Device) readRegister(addr byte) (byte, error) {
+	regs, err := d.readRegisters(addr, 1)
+	if err != nil {
+		return 0, err
+	}
+	return regs[0], nil
+}
+
+// readRegisters reads n consecutive registers starting at addr, relying on the MAX31856's
+// auto-incrementing read address.
+func (d *Device) readRegisters(addr byte, n int) ([]byte, error) {
+	buf := make([]byte, n+1)
+	buf[0] = addr
+	if err := d.bus.TransferAndReceiveData(buf); err != nil {
+		return nil, fmt.Errorf("max31856: reading register 0x%02X: %w", addr, err)
+	}
+	return buf[1:], nil
+}
+
+// writeRegister writes a single register.
+func (d *Device) writeRegister(addr, value byte) error {
+	buf := []byte{addr | writeBit, value}
+	if err := d.bus.TransferAndReceiveData(buf); err != nil {
+		return fmt.Errorf("max31856: writing register 0x%02X: %w", addr, err)
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package max31856
+
+import (
+	"strings"
+	"testing"
+)
+
+// fakeSPIBus implements embd.SPIBus over an in-memory register file, mimicking the MAX31856's
+// single-byte-address, auto-incrementing read/write protocol.
+type fakeSPIBus struct {
+	regs [0x10]byte
+}
+
+func (b *fakeSPIBus) Write(p []byte) (int, error) { return len(p), nil }
+
+func (b *fakeSPIBus) TransferAndReceiveData(buf []uint8) error {
+	addr := buf[0]
+	if addr&writeBit != 0 {
+		b.regs[addr&^writeBit] = buf[1]
+		return nil
+	}
+	for i := range buf[1:] {
+		buf[1+i] = b.regs[int(addr)+i]
+	}
+	return nil
+}
+
+func (b *fakeSPIBus) ReceiveData(len int) ([]uint8, error)           { return nil, nil }
+func (b *fakeSPIBus) TransferAndReceiveByte(data byte) (byte, error) { return 0, nil }
+func (b *fakeSPIBus) ReceiveByte() (byte, error)                     { return 0, nil }
+func (b *fakeSPIBus) Close() error                                   { return nil }
+
+func TestNewWritesDefaultConfig(t *testing.T) {
+	bus := &fakeSPIBus{}
+	if _, err := New(bus); err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if got := Type(bus.regs[regCR1] & 0x0F); got != TypeK {
+		t.Errorf("default tcType = %v, want TypeK", got)
+	}
+}
+
+func TestSetTypeAndAveraging(t *testing.T) {
+	bus := &fakeSPIBus{}
+	dev, err := New(bus)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	if err := dev.SetType(TypeS); err != nil {
+		t.Fatalf("SetType() returned error: %v", err)
+	}
+	if err := dev.SetAveraging(Average8); err != nil {
+		t.Fatalf("SetAveraging() returned error: %v", err)
+	}
+	if got := bus.regs[regCR1]; got != byte(Average8)<<4|byte(TypeS) {
+		t.Errorf("CR1 = 0x%02X, want 0x%02X", got, byte(Average8)<<4|byte(TypeS))
+	}
+}
+
+func TestReadNoFault(t *testing.T) {
+	bus := &fakeSPIBus{}
+	dev, err := New(bus)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+
+	// Cold junction: 25C = 1600 (14-bit) << 2 = 0x1900.
+	bus.regs[regCJTH] = 0x19
+	bus.regs[regCJTL] = 0x00
+	// Thermocouple: 100C = 12800 (19-bit) << 5 = 0x064000.
+	bus.regs[regLTCBH] = 0x06
+	bus.regs[regLTCBM] = 0x40
+	bus.regs[regLTCBL] = 0x00
+
+	reading, err := dev.Read()
+	if err != nil {
+		t.Fatalf("Read() returned error: %v", err)
+	}
+	if reading.Fault {
+		t.Fatalf("unexpected fault: %s", reading.FaultMessage)
+	}
+	if reading.Internal != 25.0 {
+		t.Errorf("reading.Internal = %v, want 25", reading.Internal)
+	}
+	if reading.Thermocouple != 100.0 {
+		t.Errorf("reading.Thermocouple = %v, want 100", reading.Thermocouple)
+	}
+}
+
+func TestReadFault(t *testing.T) {
+	bus := &fakeSPIBus{}
+	dev, err := New(bus)
+	if err != nil {
+		t.Fatalf("New() returned error: %v", err)
+	}
+	bus.regs[regSR] = faultOpen | faultTCHigh
+
+	reading, err := dev.Read()
+	if err != nil {
+		t.Fatalf("Read() returned error: %v", err)
+	}
+	if !reading.Fault {
+		t.Fatal("expected a fault to be reported")
+	}
+	if !strings.Contains(reading.FaultMessage, "open circuit") {
+		t.Errorf("FaultMessage = %q, want it to mention the open circuit", reading.FaultMessage)
+	}
+	if !strings.Contains(reading.FaultMessage, "high threshold") {
+		t.Errorf("FaultMessage = %q, want it to mention the high threshold", reading.FaultMessage)
+	}
+}
+
+func TestParseColdJunctionNegative(t *testing.T) {
+	// -0.015625C: 14-bit value of -1, shifted left by 2 -> 0xFFFC.
+	got := parseColdJunction(0xFF, 0xFC)
+	if got != -0.015625 {
+		t.Errorf("parseColdJunction = %v, want -0.015625", got)
+	}
+}
+
+func TestParseThermocoupleNegative(t *testing.T) {
+	// -0.0078125C: 19-bit value of -1, shifted left by 5 -> 0xFFFFE0.
+	got := parseThermocouple(0xFF, 0xFF, 0xE0)
+	if got != -0.0078125 {
+		t.Errorf("parseThermocouple = %v, want -0.0078125", got)
+	}
+}